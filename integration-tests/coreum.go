@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"cosmossdk.io/math"
 	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -14,21 +15,24 @@ import (
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	"github.com/CoreumFoundation/coreum/pkg/client"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 )
 
 // CoreumChain is configured coreum chain.
 type CoreumChain struct {
 	Chain
-	Governance             Governance
-	DeterministicGasConfig deterministicgas.Config
+	Governance                  Governance
+	DeterministicGasConfig      deterministicgas.Config
+	DeterministicGasPriceClient deterministicgastypes.QueryClient
 }
 
 // NewCoreumChain returns a new instance of the CoreumChain.
 func NewCoreumChain(chain Chain, stakerMnemonics []string) CoreumChain {
 	return CoreumChain{
-		Chain:                  chain,
-		Governance:             NewGovernance(chain.ChainContext, stakerMnemonics, chain.Faucet),
-		DeterministicGasConfig: deterministicgas.DefaultConfig(),
+		Chain:                       chain,
+		Governance:                  NewGovernance(chain.ChainContext, stakerMnemonics, chain.Faucet),
+		DeterministicGasConfig:      deterministicgas.DefaultConfig(),
+		DeterministicGasPriceClient: deterministicgastypes.NewQueryClient(chain.ClientContext),
 	}
 }
 
@@ -36,8 +40,8 @@ func NewCoreumChain(chain Chain, stakerMnemonics []string) CoreumChain {
 type BalancesOptions struct {
 	Messages                    []sdk.Msg
 	NondeterministicMessagesGas uint64
-	GasPrice                    sdk.Dec
-	Amount                      sdk.Int
+	GasPrice                    math.LegacyDec
+	Amount                      math.Int
 }
 
 // GasLimitByMsgs calculates sum of gas limits required for message types passed.
@@ -71,33 +75,42 @@ func (c CoreumChain) GasLimitByMultiSendMsgs(msgs ...sdk.Msg) uint64 {
 }
 
 // ComputeNeededBalanceFromOptions computes the required balance based on the input options.
-func (c CoreumChain) ComputeNeededBalanceFromOptions(options BalancesOptions) sdk.Int {
+func (c CoreumChain) ComputeNeededBalanceFromOptions(ctx context.Context, options BalancesOptions) (math.Int, error) {
 	if options.GasPrice.IsNil() {
-		options.GasPrice = c.ChainSettings.GasPrice
+		resp, err := c.DeterministicGasPriceClient.GasPrice(ctx, &deterministicgastypes.QueryGasPriceRequest{})
+		if err != nil {
+			return math.Int{}, errors.Wrap(err, "failed to query adaptive min gas price; "+
+				"is the deterministicgas module registered on this chain's app?")
+		}
+		options.GasPrice = resp.GasPrice
 	}
 
 	if options.Amount.IsNil() {
-		options.Amount = sdk.ZeroInt()
+		options.Amount = math.ZeroInt()
 	}
 
 	// NOTE: we assume that each message goes to one transaction, which is not
 	// very accurate and may cause some over funding in cases that there are multiple
 	// messages in a single transaction
-	totalAmount := sdk.ZeroInt()
+	totalAmount := math.ZeroInt()
 	for _, msg := range options.Messages {
 		gas := c.GasLimitByMsgs(msg)
 		// Ceil().RoundInt() is here to be compatible with the sdk's TxFactory
 		// https://github.com/cosmos/cosmos-sdk/blob/ff416ee63d32da5d520a8b2d16b00da762416146/client/tx/factory.go#L223
-		amt := options.GasPrice.Mul(sdk.NewDec(int64(gas))).Ceil().RoundInt()
+		amt := options.GasPrice.Mul(math.LegacyNewDec(int64(gas))).Ceil().RoundInt()
 		totalAmount = totalAmount.Add(amt)
 	}
 
-	return totalAmount.Add(options.GasPrice.Mul(sdk.NewDec(int64(options.NondeterministicMessagesGas))).Ceil().RoundInt()).Add(options.Amount)
+	return totalAmount.Add(options.GasPrice.Mul(math.LegacyNewDec(int64(options.NondeterministicMessagesGas))).Ceil().RoundInt()).Add(options.Amount), nil
 }
 
 // FundAccountsWithOptions computes the needed balances and fund account with it.
 func (c CoreumChain) FundAccountsWithOptions(ctx context.Context, address sdk.AccAddress, options BalancesOptions) error {
-	amount := c.ComputeNeededBalanceFromOptions(options)
+	amount, err := c.ComputeNeededBalanceFromOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
 	return c.Faucet.FundAccounts(ctx, FundedAccount{
 		Address: address,
 		Amount:  c.NewCoin(amount),
@@ -105,7 +118,7 @@ func (c CoreumChain) FundAccountsWithOptions(ctx context.Context, address sdk.Ac
 }
 
 // CreateValidator creates a new validator on the chain and returns the staker addresses, validator addresses and callback function to deactivate it.
-func (c CoreumChain) CreateValidator(ctx context.Context, stakingAmount, selfDelegationAmount sdk.Int) (sdk.AccAddress, sdk.ValAddress, func() error, error) {
+func (c CoreumChain) CreateValidator(ctx context.Context, stakingAmount, selfDelegationAmount math.Int) (sdk.AccAddress, sdk.ValAddress, func() error, error) {
 	stakingClient := stakingtypes.NewQueryClient(c.ClientContext)
 	staker := c.GenAccount()
 
@@ -123,7 +136,7 @@ func (c CoreumChain) CreateValidator(ctx context.Context, stakingAmount, selfDel
 		cosmosed25519.GenPrivKey().PubKey(),
 		c.NewCoin(stakingAmount),
 		stakingtypes.Description{Moniker: fmt.Sprintf("testing-staker-%s", staker)},
-		stakingtypes.NewCommissionRates(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.1")),
+		stakingtypes.NewCommissionRates(math.LegacyMustNewDecFromStr("0.1"), math.LegacyMustNewDecFromStr("0.1"), math.LegacyMustNewDecFromStr("0.1")),
 		selfDelegationAmount,
 	)
 	if err != nil {
@@ -183,4 +196,4 @@ func (c CoreumChain) CreateValidator(ctx context.Context, stakingAmount, selfDel
 
 		return nil
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+package integrationtests
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// TestIBCAssetFTFreezeAndWhitelist exercises the x/asset/ft IBC middleware across two chains: a
+// globally frozen token must not leave chainA over the channel, and on the returning leg a
+// receiver missing a whitelist entry must be rejected rather than waved through.
+func TestIBCAssetFTFreezeAndWhitelist(t *testing.T, chains Chains) {
+	ctx, chainA, chainB := chains.Context, chains.CoreumOne, chains.CoreumTwo
+
+	issuer := chainA.GenAccount()
+	sender := chainA.GenAccount()
+	receiver := chainB.GenAccount()
+
+	require.NoError(t, chainA.FundAccountsWithOptions(ctx, issuer, BalancesOptions{
+		Messages: []sdk.Msg{&types.MsgIssue{}},
+	}))
+	require.NoError(t, chainA.FundAccountsWithOptions(ctx, sender, BalancesOptions{
+		Messages: []sdk.Msg{&types.MsgFreeze{}},
+	}))
+
+	denom, err := chainA.IssueFT(ctx, issuer, types.IssueSettings{
+		Symbol:        "IBCT",
+		Subunit:       "ibct",
+		Precision:     6,
+		InitialAmount: math.NewInt(1_000_000),
+		Recipient:     sender,
+		Features:      []string{types.FeatureIBC},
+	})
+	require.NoError(t, err)
+
+	transferAmount := chainA.NewCoin(math.NewInt(1_000))
+
+	// Globally frozen: the outgoing transfer must be rejected before it ever reaches the escrow
+	// account on chainA.
+	require.NoError(t, chainA.SetGlobalFreeze(ctx, issuer, denom, true))
+	_, err = chainA.IBCTransfer(ctx, sender, receiver, transferAmount)
+	require.ErrorIs(t, err, types.ErrGloballyFrozen)
+	require.NoError(t, chainA.SetGlobalFreeze(ctx, issuer, denom, false))
+
+	// Not whitelisted: the receiver never whitelisted itself for denom, so the inbound transfer
+	// must be rejected rather than accepted as if no whitelisting were required.
+	require.NoError(t, chainA.SetWhitelistingEnabled(ctx, issuer, denom, true))
+	ack, err := chainA.IBCTransfer(ctx, sender, receiver, transferAmount)
+	require.NoError(t, err)
+	require.True(t, ack.IsErr(), "expected the receiving chain to reject the transfer for a non-whitelisted receiver")
+
+	// Once whitelisted for at least the transferred amount, the same transfer must succeed.
+	require.NoError(t, chainB.Whitelist(ctx, receiver, denom, transferAmount.Amount))
+	ack, err = chainA.IBCTransfer(ctx, sender, receiver, transferAmount)
+	require.NoError(t, err)
+	require.False(t, ack.IsErr())
+
+	balance := chainB.QueryBalance(ctx, receiver, denom)
+	require.Equal(t, transferAmount.Amount.String(), balance.Amount.String())
+}
+
+// TestIBCAssetFTNotEnabled verifies that a token issued without FeatureIBC is rejected outright by
+// the middleware, rather than crossing the channel unchecked.
+func TestIBCAssetFTNotEnabled(t *testing.T, chains Chains) {
+	ctx, chainA, chainB := chains.Context, chains.CoreumOne, chains.CoreumTwo
+
+	issuer := chainA.GenAccount()
+	sender := chainA.GenAccount()
+	receiver := chainB.GenAccount()
+
+	require.NoError(t, chainA.FundAccountsWithOptions(ctx, issuer, BalancesOptions{
+		Messages: []sdk.Msg{&types.MsgIssue{}},
+	}))
+
+	denom, err := chainA.IssueFT(ctx, issuer, types.IssueSettings{
+		Symbol:        "NOIBCT",
+		Subunit:       "noibct",
+		Precision:     6,
+		InitialAmount: math.NewInt(1_000_000),
+		Recipient:     sender,
+	})
+	require.NoError(t, err)
+
+	transferAmount := chainA.NewCoin(math.NewInt(1_000))
+
+	_, err = chainA.IBCTransfer(ctx, sender, receiver, transferAmount)
+	require.ErrorIs(t, err, types.ErrIBCNotEnabled)
+}
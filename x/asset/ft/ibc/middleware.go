@@ -0,0 +1,277 @@
+package ibc
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// IBCMiddleware enforces x/asset/ft freezing and whitelisting on ICS-20 transfers of tokens
+// issued by the asset/ft module. It wraps the transfer module's IBCModule and ICS4Wrapper.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      Keeper
+}
+
+// NewIBCMiddleware returns a new instance of the IBCMiddleware, wrapping app (normally the
+// transfer module) and the channel keeper used to actually send packets down the wire.
+func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, keeper Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule:   app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      keeper,
+	}
+}
+
+// SendPacket enforces freezing and whitelisting limits on the sender of an outgoing transfer
+// before handing the packet down to the underlying ICS4Wrapper.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	data, ok := decodeTransferPacketData(packet.GetData())
+	if ok {
+		sender, err := sdk.AccAddressFromBech32(data.Sender)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender in ICS-20 packet: %s", data.Sender)
+		}
+
+		coin, err := parseCoin(data)
+		if err != nil {
+			return err
+		}
+
+		escrowAddr := transfertypes.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		if err := im.checkOutgoingTransfer(ctx, sender, coin, escrowAddr); err != nil {
+			return err
+		}
+
+		if !sender.Equals(escrowAddr) {
+			im.reserveOutgoingTransfer(ctx, sender, coin)
+		}
+	}
+
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement passes through to the underlying ICS4Wrapper.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// OnRecvPacket enforces freezing and whitelisting limits on the receiver of an incoming transfer
+// before delegating to the wrapped transfer module.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, ok := decodeTransferPacketData(packet.GetData())
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// Only a token returning to this chain (i.e. this chain is the source of the denom) resolves
+	// to a native asset/ft denom; freshly arriving vouchers are not governed by this module.
+	if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver in ICS-20 packet: %s", data.Receiver))
+	}
+
+	voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	denom := data.Denom[len(voucherPrefix):]
+	if denomTrace := transfertypes.ParseDenomTrace(denom); denomTrace.Path != "" {
+		denom = denomTrace.IBCDenom()
+	}
+
+	coin, err := coinFromAmount(denom, data.Amount)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.checkIncomingTransfer(ctx, receiver, coin); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket releases the sender's outgoing-transfer reservation before delegating to
+// the wrapped transfer module: whether the ack reports success or failure, the packet's lifecycle
+// has concluded and the temporary freeze hold taken in SendPacket is no longer needed.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	im.releaseOutgoingReservation(ctx, packet)
+
+	return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket releases the sender's outgoing-transfer reservation and refunds the original
+// sender through the wrapped transfer module. The refund itself is not re-checked against freeze
+// or whitelist limits: a timeout unwinds a transfer that was already authorized when it was sent.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	im.releaseOutgoingReservation(ctx, packet)
+
+	return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// checkOutgoingTransfer blocks sends of asset/ft tokens whose sender is frozen or does not have
+// enough unfrozen balance to cover the transfer. The escrow account is exempt from the per-account
+// check: it custodies funds on behalf of the channel rather than holding them for itself, but its
+// denom remains subject to the global freeze check above.
+func (im IBCMiddleware) checkOutgoingTransfer(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, escrowAddr sdk.AccAddress) error {
+	enabled, err := im.keeper.IsIBCEnabled(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return sdkerrors.Wrapf(types.ErrIBCNotEnabled, "denom %s is not enabled for ibc transfers", coin.Denom)
+	}
+
+	frozen, err := im.keeper.IsGloballyFrozen(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "denom %s is globally frozen", coin.Denom)
+	}
+
+	if sender.Equals(escrowAddr) {
+		return nil
+	}
+
+	available := im.keeper.GetBalance(ctx, sender, coin.Denom).Sub(im.keeper.FrozenBalance(ctx, sender, coin.Denom))
+	if available.Amount.LT(coin.Amount) {
+		return sdkerrors.Wrapf(types.ErrWhitelistedLimitExceeded, "sender %s has insufficient unfrozen balance of %s to send over IBC", sender, coin.Denom)
+	}
+
+	return nil
+}
+
+// checkIncomingTransfer rejects a returning asset/ft token if the receiver is frozen, or if the
+// denom requires whitelisting and the receiver either has no whitelist entry or would exceed its
+// whitelisted cap. The escrow account is never the receiver of an inbound transfer, so no
+// special-casing is required here.
+func (im IBCMiddleware) checkIncomingTransfer(ctx sdk.Context, receiver sdk.AccAddress, coin sdk.Coin) error {
+	enabled, err := im.keeper.IsIBCEnabled(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return sdkerrors.Wrapf(types.ErrIBCNotEnabled, "denom %s is not enabled for ibc transfers", coin.Denom)
+	}
+
+	frozen, err := im.keeper.IsGloballyFrozen(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "denom %s is globally frozen", coin.Denom)
+	}
+
+	if receiverFrozen := im.keeper.FrozenBalance(ctx, receiver, coin.Denom); receiverFrozen.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "receiver %s is frozen for denom %s", receiver, coin.Denom)
+	}
+
+	whitelistingEnabled, err := im.keeper.IsWhitelistingEnabled(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if whitelistingEnabled {
+		whitelistedCap := im.keeper.WhitelistedBalance(ctx, receiver, coin.Denom)
+		resultingBalance := im.keeper.GetBalance(ctx, receiver, coin.Denom).Add(coin)
+		if resultingBalance.Amount.GT(whitelistedCap.Amount) {
+			return sdkerrors.Wrapf(types.ErrWhitelistedLimitExceeded, "receiver %s is not whitelisted to hold %s", receiver, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// reserveOutgoingTransfer bumps the sender's frozen balance by coin for the lifetime of an
+// outgoing transfer, so the same unfrozen headroom cannot be spent again by a second outgoing
+// transfer before this one's ack or timeout resolves. releaseOutgoingReservation undoes this once
+// the packet's lifecycle concludes.
+func (im IBCMiddleware) reserveOutgoingTransfer(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) {
+	frozen := im.keeper.FrozenBalance(ctx, sender, coin.Denom)
+	im.keeper.SetFrozenBalance(ctx, sender, frozen.Add(coin))
+}
+
+// releaseOutgoingReservation undoes the reservation taken by reserveOutgoingTransfer for the
+// sender of packet, if any. It is a no-op for packets this middleware never reserved against,
+// e.g. packets carrying a denom this chain did not issue.
+func (im IBCMiddleware) releaseOutgoingReservation(ctx sdk.Context, packet channeltypes.Packet) {
+	data, ok := decodeTransferPacketData(packet.GetData())
+	if !ok {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	coin, err := parseCoin(data)
+	if err != nil {
+		return
+	}
+
+	if sender.Equals(transfertypes.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())) {
+		return
+	}
+
+	frozen := im.keeper.FrozenBalance(ctx, sender, coin.Denom)
+	released := frozen.Sub(coin)
+	if released.IsNegative() {
+		released = sdk.NewCoin(coin.Denom, math.ZeroInt())
+	}
+	im.keeper.SetFrozenBalance(ctx, sender, released)
+}
+
+func decodeTransferPacketData(data []byte) (transfertypes.FungibleTokenPacketData, bool) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return transfertypes.FungibleTokenPacketData{}, false
+	}
+
+	return packetData, true
+}
+
+func parseCoin(data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	return coinFromAmount(data.Denom, data.Amount)
+}
+
+func coinFromAmount(denom, amountStr string) (sdk.Coin, error) {
+	amount, ok := math.NewIntFromString(amountStr)
+	if !ok || amount.IsNegative() {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount in ICS-20 packet: %s", amountStr)
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
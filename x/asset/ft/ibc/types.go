@@ -0,0 +1,29 @@
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper defines the subset of the x/asset/ft keeper the IBC middleware depends on.
+type Keeper interface {
+	// IsIBCEnabled returns whether the token issued under denom opted into IBC transfers by
+	// setting types.FeatureIBC at issuance.
+	IsIBCEnabled(ctx sdk.Context, denom string) (bool, error)
+	// IsGloballyFrozen returns whether all transfers of denom are currently frozen.
+	IsGloballyFrozen(ctx sdk.Context, denom string) (bool, error)
+	// IsWhitelistingEnabled returns whether denom enforces a whitelist, i.e. only addresses with a
+	// whitelist entry are allowed to hold it.
+	IsWhitelistingEnabled(ctx sdk.Context, denom string) (bool, error)
+	// FrozenBalance returns the amount of denom frozen on addr.
+	FrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	// WhitelistedBalance returns the maximum balance of denom addr is allowed to hold. It is only
+	// meaningful when IsWhitelistingEnabled returns true for denom: a zero coin then means addr has
+	// no whitelist entry at all, and is not permitted to hold denom.
+	WhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	// GetBalance returns the current balance of denom held by addr.
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	// SetFrozenBalance sets the frozen amount of denom on addr. The IBC middleware uses it to
+	// reserve the sender's unfrozen headroom for the duration of an outgoing transfer and to
+	// release that reservation once the packet's ack or timeout resolves.
+	SetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,7 +25,7 @@ type balanceStore struct {
 }
 
 func (s balanceStore) Balance(denom string) sdk.Coin {
-	balance := sdk.NewCoin(denom, sdk.ZeroInt())
+	balance := sdk.NewCoin(denom, math.ZeroInt())
 	if bz := s.store.Get([]byte(denom)); bz != nil {
 		s.cdc.MustUnmarshal(bz, &balance)
 	}
@@ -81,6 +82,41 @@ func (s balanceStore) IterateAllBalances(cb func(sdk.AccAddress, sdk.Coin) bool)
 	return nil
 }
 
+// IterateRange iterates over the balances store between start (inclusive) and end (exclusive),
+// optionally in reverse, and applies the provided callback. If true is returned from the
+// callback, iteration is stopped. A nil start or end means unbounded in that direction.
+func (s balanceStore) IterateRange(start, end []byte, reverse bool, cb func(sdk.AccAddress, sdk.Coin) bool) error {
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = s.store.ReverseIterator(start, end)
+	} else {
+		iterator = s.store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address, err := types.AddressFromBalancesStore(iterator.Key())
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address in the balances store saved with key: %s", string(iterator.Key()))
+		}
+
+		var balance sdk.Coin
+		s.cdc.MustUnmarshal(iterator.Value(), &balance)
+
+		if cb(address, balance) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// forAddress returns a balanceStore scoped to a single account within this store's namespace,
+// keyed by denom only -- the same per-account scoping Balance and SetBalance assume.
+func (s balanceStore) forAddress(addr sdk.AccAddress) balanceStore {
+	return balanceStore{cdc: s.cdc, store: prefix.NewStore(s.store, addr.Bytes())}
+}
+
 func (s balanceStore) SetBalance(coin sdk.Coin) {
 	if coin.Amount.IsZero() {
 		s.store.Delete([]byte(coin.Denom))
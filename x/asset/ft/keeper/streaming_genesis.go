@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// streamingGenesisBatchSize is the number of balance entries the StreamingGenesisImporter applies
+// before issuing a store commit, keeping peak memory usage bounded regardless of account count.
+const streamingGenesisBatchSize = 1000
+
+// balanceLine is the newline-delimited JSON record written by the StreamingGenesisExporter and
+// read back by the StreamingGenesisImporter.
+type balanceLine struct {
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+// StreamingGenesisExporter writes the freeze and whitelist balances to an io.Writer as
+// newline-delimited JSON, one line per account, without ever holding the full balance set in
+// memory. It is meant for chains with account counts large enough that collectBalances' in-memory
+// map is no longer viable.
+//
+// Neither ExportGenesis/InitGenesis nor a CLI subcommand call into this type yet: the x/asset/ft
+// Keeper, its genesis wiring and the coreumd command tree all live outside this chunk. A caller
+// wanting streaming export today constructs a StreamingGenesisExporter directly per balanceStore
+// (freezing, whitelisting) in place of collectBalances, and a matching StreamingGenesisImporter on
+// the importing side.
+type StreamingGenesisExporter struct {
+	store balanceStore
+	w     io.Writer
+}
+
+// NewStreamingGenesisExporter returns a new StreamingGenesisExporter writing to w.
+func NewStreamingGenesisExporter(store balanceStore, w io.Writer) StreamingGenesisExporter {
+	return StreamingGenesisExporter{store: store, w: w}
+}
+
+// Export streams every balance in the store to the writer, one line per account. Unlike
+// collectBalances, which coalesces coins via a full address-to-index map, Export only merges a
+// coin into the immediately preceding line. That is safe here, not just an optimization: balances
+// are stored under keys of the form address||denom, so sorted iteration always keeps every coin
+// belonging to one account contiguous, never interleaved with another account's entries.
+func (e StreamingGenesisExporter) Export() error {
+	bw := bufio.NewWriter(e.w)
+	enc := json.NewEncoder(bw)
+
+	var current *balanceLine
+	var encodeErr error
+	flush := func() bool {
+		if current == nil {
+			return false
+		}
+		if encodeErr = enc.Encode(current); encodeErr != nil {
+			return true
+		}
+		return false
+	}
+
+	iterErr := e.store.IterateAllBalances(func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if current != nil && current.Address == addr.String() {
+			current.Coins = current.Coins.Add(coin)
+			return false
+		}
+
+		stop := flush()
+		current = &balanceLine{Address: addr.String(), Coins: sdk.NewCoins(coin)}
+		return stop
+	})
+	if iterErr != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to stream balances: %s", iterErr)
+	}
+	if encodeErr != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to encode balance entry: %s", encodeErr)
+	}
+
+	if flush(); encodeErr != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to flush last balance entry: %s", encodeErr)
+	}
+
+	return bw.Flush()
+}
+
+// StreamingGenesisImporter reads back newline-delimited balance entries produced by a
+// StreamingGenesisExporter and applies them in batches, committing the store periodically so
+// memory usage stays bounded regardless of account count.
+type StreamingGenesisImporter struct {
+	store  balanceStore
+	r      io.Reader
+	commit func()
+}
+
+// NewStreamingGenesisImporter returns a new StreamingGenesisImporter reading from r. commit is
+// invoked every streamingGenesisBatchSize entries to flush the underlying store to disk.
+func NewStreamingGenesisImporter(store balanceStore, r io.Reader, commit func()) StreamingGenesisImporter {
+	return StreamingGenesisImporter{store: store, r: r, commit: commit}
+}
+
+// Import reads every balance entry and applies it via SetBalance on a balanceStore scoped to the
+// entry's own address, committing every streamingGenesisBatchSize entries.
+func (i StreamingGenesisImporter) Import() error {
+	dec := json.NewDecoder(bufio.NewReader(i.r))
+
+	count := 0
+	for dec.More() {
+		var line balanceLine
+		if err := dec.Decode(&line); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to decode balance entry: %s", err)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(line.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address in balance entry: %s", line.Address)
+		}
+
+		accountStore := i.store.forAddress(addr)
+		for _, coin := range line.Coins {
+			accountStore.SetBalance(coin)
+		}
+
+		count++
+		if count%streamingGenesisBatchSize == 0 && i.commit != nil {
+			i.commit()
+		}
+	}
+
+	if i.commit != nil {
+		i.commit()
+	}
+
+	return nil
+}
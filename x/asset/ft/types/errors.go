@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Errors used by the IBC freeze/whitelist enforcement added on top of x/asset/ft.
+var (
+	// ErrGloballyFrozen is returned when an operation is rejected because the token, or the
+	// relevant account, is frozen.
+	ErrGloballyFrozen = sdkerrors.Register(ModuleName, 20, "globally frozen")
+
+	// ErrWhitelistedLimitExceeded is returned when an operation would push an account's balance
+	// of a token past the cap it has been whitelisted for. The IBC middleware also returns it when
+	// the sender of an outgoing transfer lacks enough unfrozen balance to cover the amount sent.
+	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 21, "whitelisted limit exceeded")
+
+	// ErrIBCNotEnabled is returned when a token is sent over IBC without having been issued with
+	// FeatureIBC.
+	ErrIBCNotEnabled = sdkerrors.Register(ModuleName, 22, "ibc transfers are not enabled for denom")
+)
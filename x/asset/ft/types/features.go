@@ -0,0 +1,6 @@
+package types
+
+// FeatureIBC is the feature flag a token must be issued with to be transferred over IBC. A token
+// issued without it is rejected outright by the x/asset/ft IBC middleware's IsIBCEnabled check,
+// before the transfer ever reaches the escrow account.
+const FeatureIBC = "ibc"
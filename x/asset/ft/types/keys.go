@@ -0,0 +1,4 @@
+package types
+
+// ModuleName defines the x/asset/ft module name.
+const ModuleName = "assetft"
@@ -0,0 +1,40 @@
+package deterministicgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Config is the configuration for deterministic gas amounts required by messages.
+type Config struct {
+	// FixedGas is the gas charged once per transaction regardless of the messages it contains.
+	FixedGas uint64
+
+	gasByMsgURL map[string]uint64
+}
+
+// DefaultConfig returns the default deterministic gas configuration used by the coreum chain.
+func DefaultConfig() Config {
+	return Config{
+		FixedGas: 60_000,
+		gasByMsgURL: map[string]uint64{
+			sdk.MsgTypeURL(&banktypes.MsgSend{}):                           30_000,
+			sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):                      30_000,
+			sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}):             70_000,
+			sdk.MsgTypeURL(&stakingtypes.MsgEditValidator{}):               15_000,
+			sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                    50_000,
+			sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                  70_000,
+			sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):             80_000,
+			sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}): 60_000,
+		},
+	}
+}
+
+// GasRequiredByMessage returns the deterministic gas required by the message, and whether the
+// message is registered in the config at all.
+func (c Config) GasRequiredByMessage(msg sdk.Msg) (uint64, bool) {
+	gas, exists := c.gasByMsgURL[sdk.MsgTypeURL(msg)]
+	return gas, exists
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker updates the adaptive min gas price based on the current block's utilization. It must
+// run in EndBlock rather than BeginBlock: baseapp resets the block gas meter before BeginBlockers
+// run and before any transaction in the block executes, so ctx.BlockGasMeter() would always read
+// zero gas consumed if read there. By EndBlock, the same context's block gas meter reflects every
+// transaction the block actually delivered.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	gasWanted := uint64(ctx.BlockGasMeter().Limit())
+	gasUsed := uint64(ctx.BlockGasMeter().GasConsumed())
+
+	newPrice := k.UpdateGasPrice(ctx, gasUsed, gasWanted)
+
+	k.Logger(ctx).Debug("updated adaptive min gas price", "gasPrice", newPrice.String())
+}
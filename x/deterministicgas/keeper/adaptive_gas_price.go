@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordUtilization appends the current block's gasUsed/gasWanted ratio to the rolling window,
+// trimming it down to the configured window size, and returns the updated window.
+func (k Keeper) recordUtilization(ctx sdk.Context, utilization math.LegacyDec, window []math.LegacyDec, windowSize uint32) []math.LegacyDec {
+	window = append(window, utilization)
+	if uint32(len(window)) > windowSize {
+		window = window[uint32(len(window))-windowSize:]
+	}
+
+	return window
+}
+
+// nextGasPrice computes the next adaptive min gas price given the current price, the average
+// utilization over the rolling window and the governance-controlled params. The price is moved
+// by `1 + k*(util - target)` and clamped to [MinGasPrice, MaxGasPrice].
+func nextGasPrice(currentPrice, avgUtilization, target, k math.LegacyDec, minPrice, maxPrice math.LegacyDec) math.LegacyDec {
+	adjustment := math.LegacyOneDec().Add(k.Mul(avgUtilization.Sub(target)))
+	if adjustment.IsNegative() {
+		adjustment = math.LegacyZeroDec()
+	}
+
+	price := currentPrice.Mul(adjustment)
+	switch {
+	case price.LT(minPrice):
+		return minPrice
+	case price.GT(maxPrice):
+		return maxPrice
+	default:
+		return price
+	}
+}
+
+func averageUtilization(window []math.LegacyDec) math.LegacyDec {
+	if len(window) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	sum := math.LegacyZeroDec()
+	for _, u := range window {
+		sum = sum.Add(u)
+	}
+
+	return sum.QuoInt64(int64(len(window)))
+}
+
+// UpdateGasPrice recomputes and persists the adaptive min gas price for the current block, using
+// the block's gasUsed/gasWanted ratio as the latest utilization sample.
+func (k Keeper) UpdateGasPrice(ctx sdk.Context, gasUsed, gasWanted uint64) math.LegacyDec {
+	params := k.GetParams(ctx)
+
+	utilization := math.LegacyZeroDec()
+	if gasWanted > 0 {
+		utilization = math.LegacyNewDec(int64(gasUsed)).QuoInt64(int64(gasWanted))
+	}
+
+	window := k.recordUtilization(ctx, utilization, k.getUtilizationWindow(ctx), params.WindowSize)
+	k.setUtilizationWindow(ctx, window)
+
+	newPrice := nextGasPrice(
+		k.GetGasPrice(ctx),
+		averageUtilization(window),
+		params.TargetUtilization,
+		params.AdjustmentCoefficient,
+		params.MinGasPrice,
+		params.MaxGasPrice,
+	)
+	k.setGasPrice(ctx, newPrice)
+
+	return newPrice
+}
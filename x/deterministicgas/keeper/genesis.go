@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.setGasPrice(ctx, genState.CurrentGasPrice)
+	k.setUtilizationWindow(ctx, genState.UtilizationWindow)
+}
+
+// ExportGenesis returns the module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	return types.GenesisState{
+		Params:            k.GetParams(ctx),
+		CurrentGasPrice:   k.GetGasPrice(ctx),
+		UtilizationWindow: k.getUtilizationWindow(ctx),
+	}
+}
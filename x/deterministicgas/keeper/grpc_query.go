@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GasPrice implements the Query/GasPrice gRPC method.
+func (k Keeper) GasPrice(c context.Context, _ *types.QueryGasPriceRequest) (*types.QueryGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGasPriceResponse{
+		GasPrice: k.GetGasPrice(ctx),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// windowSeparator separates serialized utilization ratios in the stored window blob.
+const windowSeparator = ","
+
+// Keeper manages the state of the adaptive gas price mechanism.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the current module params.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetGasPrice returns the current adaptive min gas price.
+func (k Keeper) GetGasPrice(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CurrentGasPriceKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinGasPrice
+	}
+
+	price, err := math.LegacyNewDecFromStr(string(bz))
+	if err != nil {
+		panic(fmt.Errorf("invalid gas price stored: %w", err))
+	}
+
+	return price
+}
+
+// setGasPrice persists the current adaptive min gas price.
+func (k Keeper) setGasPrice(ctx sdk.Context, price math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CurrentGasPriceKey, []byte(price.String()))
+}
+
+// getUtilizationWindow returns the rolling window of past block utilization ratios, oldest first.
+func (k Keeper) getUtilizationWindow(ctx sdk.Context) []math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UtilizationWindowKey)
+	if bz == nil {
+		return nil
+	}
+
+	var window []math.LegacyDec
+	for _, raw := range strings.Split(string(bz), windowSeparator) {
+		u, err := math.LegacyNewDecFromStr(raw)
+		if err != nil {
+			panic(fmt.Errorf("invalid utilization window stored: %w", err))
+		}
+		window = append(window, u)
+	}
+
+	return window
+}
+
+// setUtilizationWindow persists the rolling window of past block utilization ratios.
+func (k Keeper) setUtilizationWindow(ctx sdk.Context, window []math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	if len(window) == 0 {
+		store.Delete(types.UtilizationWindowKey)
+		return
+	}
+
+	raws := make([]string, len(window))
+	for i, u := range window {
+		raws[i] = u.String()
+	}
+	store.Set(types.UtilizationWindowKey, []byte(strings.Join(raws, windowSeparator)))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// GenesisState defines the deterministicgas module's genesis state.
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+	// CurrentGasPrice is the adaptive min gas price in effect at genesis.
+	CurrentGasPrice math.LegacyDec `json:"current_gas_price" yaml:"current_gas_price"`
+	// UtilizationWindow is the rolling window of per-block utilization ratios carried over from export.
+	UtilizationWindow []math.LegacyDec `json:"utilization_window" yaml:"utilization_window"`
+}
+
+// DefaultGenesis returns the default genesis state of the deterministicgas module.
+func DefaultGenesis() GenesisState {
+	params := DefaultParams()
+	return GenesisState{
+		Params:            params,
+		CurrentGasPrice:   params.MinGasPrice,
+		UtilizationWindow: nil,
+	}
+}
+
+// Validate validates the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.CurrentGasPrice.IsNil() || gs.CurrentGasPrice.IsNegative() {
+		return fmt.Errorf("current gas price must be non-negative: %s", gs.CurrentGasPrice)
+	}
+	if len(gs.UtilizationWindow) > int(gs.Params.WindowSize) {
+		return fmt.Errorf("utilization window longer than window size param")
+	}
+	for _, u := range gs.UtilizationWindow {
+		if u.IsNil() || u.IsNegative() {
+			return fmt.Errorf("utilization window entry must be non-negative: %s", u)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "deterministicgas"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// Store key prefixes for the adaptive gas price subsystem.
+var (
+	// CurrentGasPriceKey stores the current adaptive min gas price.
+	CurrentGasPriceKey = []byte{0x01}
+
+	// UtilizationWindowKey stores the rolling window of per-block utilization ratios.
+	UtilizationWindowKey = []byte{0x02}
+)
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values for the adaptive gas price mechanism.
+var (
+	DefaultWindowSize             uint32 = 100
+	DefaultTargetUtilization             = math.LegacyMustNewDecFromStr("0.5")
+	DefaultAdjustmentCoefficient         = math.LegacyMustNewDecFromStr("0.125")
+	DefaultMinGasPrice                   = math.LegacyMustNewDecFromStr("0.0625")
+	DefaultMaxGasPrice                   = math.LegacyMustNewDecFromStr("625")
+)
+
+// Parameter store keys.
+var (
+	KeyWindowSize            = []byte("WindowSize")
+	KeyTargetUtilization     = []byte("TargetUtilization")
+	KeyAdjustmentCoefficient = []byte("AdjustmentCoefficient")
+	KeyMinGasPrice           = []byte("MinGasPrice")
+	KeyMaxGasPrice           = []byte("MaxGasPrice")
+)
+
+// ParamKeyTable returns the param key table for the deterministicgas module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params is the set of parameters governing the adaptive gas price mechanism.
+type Params struct {
+	// WindowSize is the number of past blocks used to compute the rolling average utilization.
+	WindowSize uint32 `json:"window_size" yaml:"window_size"`
+	// TargetUtilization is the gasUsed/gasWanted ratio the mechanism adjusts the price towards.
+	TargetUtilization math.LegacyDec `json:"target_utilization" yaml:"target_utilization"`
+	// AdjustmentCoefficient (k) controls how aggressively the price reacts to utilization deviating from the target.
+	AdjustmentCoefficient math.LegacyDec `json:"adjustment_coefficient" yaml:"adjustment_coefficient"`
+	// MinGasPrice is the floor the adaptive price is clamped to.
+	MinGasPrice math.LegacyDec `json:"min_gas_price" yaml:"min_gas_price"`
+	// MaxGasPrice is the cap the adaptive price is clamped to.
+	MaxGasPrice math.LegacyDec `json:"max_gas_price" yaml:"max_gas_price"`
+}
+
+// DefaultParams returns the default adaptive gas price params.
+func DefaultParams() Params {
+	return Params{
+		WindowSize:            DefaultWindowSize,
+		TargetUtilization:     DefaultTargetUtilization,
+		AdjustmentCoefficient: DefaultAdjustmentCoefficient,
+		MinGasPrice:           DefaultMinGasPrice,
+		MaxGasPrice:           DefaultMaxGasPrice,
+	}
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyWindowSize, &p.WindowSize, validateWindowSize),
+		paramtypes.NewParamSetPair(KeyTargetUtilization, &p.TargetUtilization, validateUnitDec),
+		paramtypes.NewParamSetPair(KeyAdjustmentCoefficient, &p.AdjustmentCoefficient, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyMaxGasPrice, &p.MaxGasPrice, validateNonNegativeDec),
+	}
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := validateWindowSize(p.WindowSize); err != nil {
+		return err
+	}
+	if err := validateUnitDec(p.TargetUtilization); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDec(p.AdjustmentCoefficient); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDec(p.MinGasPrice); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDec(p.MaxGasPrice); err != nil {
+		return err
+	}
+	if p.MinGasPrice.GT(p.MaxGasPrice) {
+		return fmt.Errorf("min gas price %s must not be greater than max gas price %s", p.MinGasPrice, p.MaxGasPrice)
+	}
+
+	return nil
+}
+
+func validateWindowSize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("window size must be positive")
+	}
+
+	return nil
+}
+
+func validateUnitDec(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("value must be in range [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateNonNegativeDec(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("value must be non-negative: %s", v)
+	}
+
+	return nil
+}